@@ -0,0 +1,231 @@
+// Package agent drives the tool-call loop common to every chat-completion
+// based agent: call the model, dispatch any tool calls it makes to
+// registered Go handlers, feed the results back, and repeat until the
+// model stops calling tools.
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// ToolHandler executes a single tool call. rawArgs is the model's raw JSON
+// arguments for this call; the return value is marshaled into the tool
+// message content sent back to the model.
+type ToolHandler func(ctx context.Context, rawArgs json.RawMessage) (any, error)
+
+type registeredTool struct {
+	definition openai.FunctionDefinition
+	handler    ToolHandler
+}
+
+// StepEvent describes one round trip of the agent loop: the assistant
+// message for that step plus any tool responses it produced, in the order
+// they were appended to the conversation.
+type StepEvent struct {
+	Step     int
+	Messages []openai.ChatCompletionMessage
+	Usage    openai.Usage
+}
+
+// UnknownToolError is returned (wrapped) from Run when the model calls a
+// tool name that was never registered with RegisterTool.
+type UnknownToolError struct {
+	Name string
+}
+
+func (e *UnknownToolError) Error() string {
+	return fmt.Sprintf("agent: unknown tool %q", e.Name)
+}
+
+// ToolPanicError is returned (wrapped) from Run when a registered tool
+// handler panics instead of returning an error.
+type ToolPanicError struct {
+	Name  string
+	Value any
+}
+
+func (e *ToolPanicError) Error() string {
+	return fmt.Sprintf("agent: tool %q panicked: %v", e.Name, e.Value)
+}
+
+// NoChoicesError is returned (wrapped) from Run when the provider responds
+// with an empty Choices array instead of an error.
+type NoChoicesError struct {
+	Step int
+}
+
+func (e *NoChoicesError) Error() string {
+	return fmt.Sprintf("agent: step %d: provider returned no choices", e.Step)
+}
+
+// Runner drives the tool-call loop for a single ChatCompletionRequest: it
+// repeatedly calls CreateChatCompletion, dispatches any tool calls the
+// model makes to the handlers registered with RegisterTool, and feeds
+// their results back until the model stops calling tools or MaxSteps is
+// reached.
+type Runner struct {
+	// MaxSteps bounds the number of CreateChatCompletion round trips. Zero
+	// means unlimited.
+	MaxSteps int
+	// Concurrency bounds how many tool calls within a single step are
+	// dispatched at once. Zero (the default) dispatches them all at once.
+	Concurrency int
+	// OnStep, if set, is called after each step's messages are appended to
+	// the conversation, so callers can log or stream intermediate
+	// assistant/tool messages.
+	OnStep func(StepEvent)
+
+	client  *openai.Client
+	request openai.ChatCompletionRequest
+	tools   map[string]registeredTool
+}
+
+// NewRunner builds a Runner that sends baseRequest with whatever tools are
+// registered before Run is called. Run replaces baseRequest.Tools with the
+// registered tools; tools already set on baseRequest are not sent.
+func NewRunner(client *openai.Client, baseRequest openai.ChatCompletionRequest) *Runner {
+	return &Runner{
+		client:  client,
+		request: baseRequest,
+		tools:   map[string]registeredTool{},
+	}
+}
+
+// RegisterTool adds a callable tool to the runner. paramsSchema is passed
+// through to FunctionDefinition.Parameters unchanged, so it accepts the
+// same values that field does (a map[string]any, or a *jsonschema.Schema).
+func (r *Runner) RegisterTool(name, description string, paramsSchema any, fn ToolHandler) {
+	r.tools[name] = registeredTool{
+		definition: openai.FunctionDefinition{
+			Name:        name,
+			Description: description,
+			Parameters:  paramsSchema,
+		},
+		handler: fn,
+	}
+}
+
+// Run starts a conversation with userMessage and drives the tool-call loop
+// to completion, returning the final assistant message.
+func (r *Runner) Run(ctx context.Context, userMessage string) (openai.ChatCompletionMessage, error) {
+	req := r.request
+	req.Tools = make([]openai.Tool, 0, len(r.tools))
+	for _, t := range r.tools {
+		definition := t.definition
+		req.Tools = append(req.Tools, openai.Tool{Type: openai.ToolTypeFunction, Function: &definition})
+	}
+	req.Messages = append(append([]openai.ChatCompletionMessage{}, req.Messages...), openai.ChatCompletionMessage{
+		Role:    openai.ChatMessageRoleUser,
+		Content: userMessage,
+	})
+
+	var totalUsage openai.Usage
+	for step := 1; r.MaxSteps == 0 || step <= r.MaxSteps; step++ {
+		resp, err := r.client.CreateChatCompletion(ctx, req)
+		if err != nil {
+			return openai.ChatCompletionMessage{}, fmt.Errorf("agent: step %d: %w", step, err)
+		}
+		totalUsage.PromptTokens += resp.Usage.PromptTokens
+		totalUsage.CompletionTokens += resp.Usage.CompletionTokens
+		totalUsage.TotalTokens += resp.Usage.TotalTokens
+
+		if len(resp.Choices) == 0 {
+			return openai.ChatCompletionMessage{}, &NoChoicesError{Step: step}
+		}
+		assistantMsg := resp.Choices[0].Message
+		req.Messages = append(req.Messages, assistantMsg)
+		stepMessages := []openai.ChatCompletionMessage{assistantMsg}
+
+		if len(assistantMsg.ToolCalls) == 0 {
+			if r.OnStep != nil {
+				r.OnStep(StepEvent{Step: step, Messages: stepMessages, Usage: totalUsage})
+			}
+			return assistantMsg, nil
+		}
+
+		toolMessages, err := r.dispatchToolCalls(ctx, assistantMsg.ToolCalls)
+		if err != nil {
+			return openai.ChatCompletionMessage{}, err
+		}
+		req.Messages = append(req.Messages, toolMessages...)
+
+		if r.OnStep != nil {
+			r.OnStep(StepEvent{
+				Step:     step,
+				Messages: append(stepMessages, toolMessages...),
+				Usage:    totalUsage,
+			})
+		}
+	}
+	return openai.ChatCompletionMessage{}, fmt.Errorf("agent: exceeded MaxSteps (%d) without a final answer", r.MaxSteps)
+}
+
+// dispatchToolCalls runs calls through their registered handlers, up to
+// r.Concurrency at a time, and returns one tool message per call in the
+// same order as calls.
+func (r *Runner) dispatchToolCalls(ctx context.Context, calls []openai.ToolCall) ([]openai.ChatCompletionMessage, error) {
+	results := make([]openai.ChatCompletionMessage, len(calls))
+	errs := make([]error, len(calls))
+
+	concurrency := r.Concurrency
+	if concurrency <= 0 {
+		concurrency = len(calls)
+	}
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, call := range calls {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, call openai.ToolCall) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = r.callTool(ctx, call)
+		}(i, call)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// callTool dispatches a single tool call to its registered handler,
+// converting an unknown tool name or a handler panic into a typed error
+// instead of fabricating a result for the model to react to.
+func (r *Runner) callTool(ctx context.Context, call openai.ToolCall) (msg openai.ChatCompletionMessage, err error) {
+	tool, ok := r.tools[call.Function.Name]
+	if !ok {
+		return openai.ChatCompletionMessage{}, &UnknownToolError{Name: call.Function.Name}
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = &ToolPanicError{Name: call.Function.Name, Value: rec}
+		}
+	}()
+
+	result, err := tool.handler(ctx, json.RawMessage(call.Function.Arguments))
+	if err != nil {
+		return openai.ChatCompletionMessage{}, fmt.Errorf("agent: tool %q: %w", call.Function.Name, err)
+	}
+
+	content, err := json.Marshal(result)
+	if err != nil {
+		return openai.ChatCompletionMessage{}, fmt.Errorf("agent: tool %q: marshaling result: %w", call.Function.Name, err)
+	}
+
+	return openai.ChatCompletionMessage{
+		Role:       openai.ChatMessageRoleTool,
+		Content:    string(content),
+		ToolCallID: call.ID,
+	}, nil
+}
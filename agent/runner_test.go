@@ -0,0 +1,129 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+func TestDispatchToolCallsPreservesOrder(t *testing.T) {
+	r := NewRunner(nil, openai.ChatCompletionRequest{})
+	r.RegisterTool("slow", "", nil, func(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+		time.Sleep(20 * time.Millisecond)
+		return "slow", nil
+	})
+	r.RegisterTool("fast", "", nil, func(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+		return "fast", nil
+	})
+
+	calls := []openai.ToolCall{
+		{ID: "1", Function: openai.FunctionCall{Name: "slow"}},
+		{ID: "2", Function: openai.FunctionCall{Name: "fast"}},
+	}
+
+	results, err := r.dispatchToolCalls(context.Background(), calls)
+	if err != nil {
+		t.Fatalf("dispatchToolCalls returned error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].ToolCallID != "1" || results[0].Content != `"slow"` {
+		t.Errorf("results[0] = %+v, want tool call 1 (slow)", results[0])
+	}
+	if results[1].ToolCallID != "2" || results[1].Content != `"fast"` {
+		t.Errorf("results[1] = %+v, want tool call 2 (fast)", results[1])
+	}
+}
+
+func TestDispatchToolCallsRespectsConcurrency(t *testing.T) {
+	r := NewRunner(nil, openai.ChatCompletionRequest{})
+	r.Concurrency = 2
+
+	var (
+		mu          sync.Mutex
+		inFlight    int
+		maxInFlight int
+	)
+	r.RegisterTool("work", "", nil, func(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return nil, nil
+	})
+
+	calls := make([]openai.ToolCall, 6)
+	for i := range calls {
+		calls[i] = openai.ToolCall{ID: "x", Function: openai.FunctionCall{Name: "work"}}
+	}
+
+	if _, err := r.dispatchToolCalls(context.Background(), calls); err != nil {
+		t.Fatalf("dispatchToolCalls returned error: %v", err)
+	}
+	if maxInFlight > r.Concurrency {
+		t.Errorf("max concurrent tool calls = %d, want <= %d", maxInFlight, r.Concurrency)
+	}
+}
+
+func TestDispatchToolCallsUnknownTool(t *testing.T) {
+	r := NewRunner(nil, openai.ChatCompletionRequest{})
+	calls := []openai.ToolCall{{ID: "1", Function: openai.FunctionCall{Name: "missing"}}}
+
+	_, err := r.dispatchToolCalls(context.Background(), calls)
+	var unknown *UnknownToolError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("dispatchToolCalls error = %v, want *UnknownToolError", err)
+	}
+	if unknown.Name != "missing" {
+		t.Errorf("UnknownToolError.Name = %q, want %q", unknown.Name, "missing")
+	}
+}
+
+func TestDispatchToolCallsPanic(t *testing.T) {
+	r := NewRunner(nil, openai.ChatCompletionRequest{})
+	r.RegisterTool("boom", "", nil, func(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+		panic("kaboom")
+	})
+	calls := []openai.ToolCall{{ID: "1", Function: openai.FunctionCall{Name: "boom"}}}
+
+	_, err := r.dispatchToolCalls(context.Background(), calls)
+	var panicErr *ToolPanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("dispatchToolCalls error = %v, want *ToolPanicError", err)
+	}
+}
+
+func TestRunReturnsNoChoicesError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{})
+	}))
+	defer srv.Close()
+
+	cfg := openai.DefaultConfig("test-token")
+	cfg.BaseURL = srv.URL
+	client := openai.NewClientWithConfig(cfg)
+
+	r := NewRunner(client, openai.ChatCompletionRequest{Model: "test-model"})
+	_, err := r.Run(context.Background(), "hello")
+
+	var noChoices *NoChoicesError
+	if !errors.As(err, &noChoices) {
+		t.Fatalf("Run error = %v, want wrapped *NoChoicesError", err)
+	}
+}
@@ -0,0 +1,26 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sashabaranov/go-openai/jsonschema"
+)
+
+// RegisterTypedTool registers a tool whose parameters schema is derived
+// from T via jsonschema.From instead of being declared by hand, so the
+// schema the model sees can't drift from the Go type fn unmarshals into.
+//
+// It's a package-level function rather than a Runner method because Go
+// methods can't take their own type parameters.
+func RegisterTypedTool[T any](r *Runner, name, description string, fn func(ctx context.Context, args T) (any, error)) {
+	schema := jsonschema.From[T]()
+	r.RegisterTool(name, description, &schema, func(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+		var args T
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return nil, fmt.Errorf("agent: unmarshaling arguments for %q: %w", name, err)
+		}
+		return fn(ctx, args)
+	})
+}
@@ -0,0 +1,115 @@
+package openai
+
+const (
+	ChatMessageRoleSystem    = "system"
+	ChatMessageRoleUser      = "user"
+	ChatMessageRoleAssistant = "assistant"
+	ChatMessageRoleTool      = "tool"
+)
+
+// ToolType identifies the kind of Tool offered to the model. Function is
+// currently the only supported type.
+type ToolType string
+
+const (
+	ToolTypeFunction ToolType = "function"
+)
+
+// FunctionDefinition describes a callable function offered to the model as
+// a Tool. Parameters stays untyped (any) so it can hold a hand-assembled
+// map[string]any JSON Schema object or, preferably, a jsonschema.Schema
+// built with the jsonschema package's fluent builder or its reflect-based
+// From — either marshals to the same JSON Schema object on the wire.
+type FunctionDefinition struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Parameters  any    `json:"parameters,omitempty"`
+}
+
+// Tool is an entry in ChatCompletionRequest.Tools offered to the model.
+type Tool struct {
+	Type     ToolType            `json:"type"`
+	Function *FunctionDefinition `json:"function,omitempty"`
+}
+
+// FunctionCall is the name and JSON-encoded arguments of a single tool
+// invocation requested by the model.
+type FunctionCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// ToolCall is one entry in ChatCompletionMessage.ToolCalls.
+type ToolCall struct {
+	ID       string       `json:"id"`
+	Type     ToolType     `json:"type"`
+	Function FunctionCall `json:"function"`
+}
+
+// ChatMessagePart is one entry in ChatCompletionMessage.MultiContent, used
+// for providers (such as Gemini) that return structured, multi-part
+// message content instead of a single Content string.
+type ChatMessagePart struct {
+	Type      string `json:"type"`
+	Text      string `json:"text,omitempty"`
+	ExtraPart any    `json:"extra_part,omitempty"`
+}
+
+// ChatCompletionMessage is a single message in a ChatCompletionRequest's
+// Messages, or the Message returned in a ChatCompletionChoice.
+type ChatCompletionMessage struct {
+	Role         string            `json:"role"`
+	Content      string            `json:"content,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	MultiContent []ChatMessagePart `json:"multi_content,omitempty"`
+	ToolCalls    []ToolCall        `json:"tool_calls,omitempty"`
+	ToolCallID   string            `json:"tool_call_id,omitempty"`
+
+	// Thoughts holds the model's intermediate reasoning when the request
+	// set Thinking.IncludeThoughts. It is populated from the provider's
+	// "thought" content parts; it is left nil for providers that don't
+	// return any.
+	Thoughts []ThoughtPart `json:"-"`
+}
+
+// ChatCompletionRequest is the payload sent to the chat completions
+// endpoint.
+type ChatCompletionRequest struct {
+	Model      string                  `json:"model"`
+	Messages   []ChatCompletionMessage `json:"messages"`
+	Tools      []Tool                  `json:"tools,omitempty"`
+	ToolChoice any                     `json:"tool_choice,omitempty"`
+	ExtraBody  map[string]any          `json:"extra_body,omitempty"`
+
+	// Thinking configures Gemini's extended thinking. It is a no-op on the
+	// wire for non-Gemini providers; see Config.Provider.
+	Thinking *ThinkingConfig `json:"-"`
+
+	// FunctionCalling configures Gemini/Vertex's function_calling_config,
+	// which is independent of (and sits alongside) ToolChoice. It is a
+	// no-op on the wire for non-Gemini providers.
+	FunctionCalling *FunctionCallingConfig `json:"-"`
+}
+
+// Usage reports token accounting for a completion.
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// ChatCompletionChoice is one entry in ChatCompletionResponse.Choices.
+type ChatCompletionChoice struct {
+	Index        int                   `json:"index"`
+	Message      ChatCompletionMessage `json:"message"`
+	FinishReason string                `json:"finish_reason"`
+}
+
+// ChatCompletionResponse is the decoded response from the chat completions
+// endpoint.
+type ChatCompletionResponse struct {
+	ID      string                 `json:"id"`
+	Model   string                 `json:"model"`
+	Choices []ChatCompletionChoice `json:"choices"`
+	Usage   Usage                  `json:"usage"`
+}
@@ -0,0 +1,77 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Client is an OpenAI (or OpenAI-compatible) API client built from a Config.
+type Client struct {
+	config Config
+}
+
+// NewClient builds a Client authenticating with a static bearer token
+// against the public OpenAI API.
+func NewClient(authToken string) *Client {
+	return NewClientWithConfig(DefaultConfig(authToken))
+}
+
+// NewClientWithConfig builds a Client from an explicit Config, e.g. one
+// returned by NewVertexAIConfig.
+func NewClientWithConfig(config Config) *Client {
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{}
+	}
+	return &Client{config: config}
+}
+
+// CreateChatCompletion sends a chat completion request and returns the
+// decoded response.
+func (c *Client) CreateChatCompletion(
+	ctx context.Context,
+	request ChatCompletionRequest,
+) (response ChatCompletionResponse, err error) {
+	applyGeminiExtensions(&request, c.config)
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return response, fmt.Errorf("marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.config.BaseURL+"/chat/completions",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return response, fmt.Errorf("building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.config.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.authToken)
+	}
+
+	res, err := c.config.HTTPClient.Do(req)
+	if err != nil {
+		return response, fmt.Errorf("sending request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(res.Body)
+		return response, fmt.Errorf("openai: unexpected status %d: %s", res.StatusCode, errBody)
+	}
+
+	if err = json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return response, fmt.Errorf("decoding response: %w", err)
+	}
+	for i := range response.Choices {
+		extractThoughts(&response.Choices[i].Message)
+	}
+	return response, nil
+}
@@ -0,0 +1,55 @@
+package openai
+
+import "net/http"
+
+const (
+	apiURLv1 = "https://api.openai.com/v1"
+)
+
+// APIType is the type of API used for a given Config.
+type APIType string
+
+const (
+	APITypeOpenAI APIType = "OPEN_AI"
+	APITypeAzure  APIType = "AZURE"
+)
+
+// Provider identifies the family of API a Config talks to, for the rare
+// cases where behavior can't be inferred from BaseURL alone (e.g. a Gemini
+// deployment reachable through a non-Google proxy URL).
+type Provider string
+
+const (
+	ProviderOpenAI Provider = ""
+	ProviderGemini Provider = "gemini"
+)
+
+// Config holds the settings required to talk to an OpenAI-compatible
+// endpoint. Build one with DefaultConfig and tweak the exported fields, or
+// use one of the provider-specific constructors such as NewVertexAIConfig.
+type Config struct {
+	BaseURL    string
+	OrgID      string
+	APIType    APIType
+	APIVersion string
+	HTTPClient *http.Client
+
+	// Provider set to ProviderGemini enables Gemini-only features, such as
+	// ChatCompletionRequest.Thinking, whatever the BaseURL. The zero value,
+	// ProviderOpenAI, leaves detection to BaseURL, so a Vertex AI BaseURL is
+	// still treated as Gemini.
+	Provider Provider
+
+	authToken string
+}
+
+// DefaultConfig returns a Config that authenticates with the given static
+// bearer token against the public OpenAI API.
+func DefaultConfig(authToken string) Config {
+	return Config{
+		BaseURL:    apiURLv1,
+		APIType:    APITypeOpenAI,
+		HTTPClient: &http.Client{},
+		authToken:  authToken,
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	openai "github.com/sashabaranov/go-openai"
+	"github.com/sashabaranov/go-openai/agent"
+)
+
+// weatherArgs is both the schema jsonschema.From derives the tool
+// definition from and the type get_weather's handler unmarshals into, so
+// the two can't drift apart.
+type weatherArgs struct {
+	City string `json:"city" jsonschema:"description=City name, e.g. Seattle"`
+}
+
+func main() {
+	ctx := context.Background()
+
+	client := openai.NewClient("your-api-key")
+
+	runner := agent.NewRunner(client, openai.ChatCompletionRequest{
+		Model: openai.GPT4o,
+	})
+	runner.MaxSteps = 5
+	runner.OnStep = func(event agent.StepEvent) {
+		fmt.Printf("-- step %d (usage so far: %+v) --\n", event.Step, event.Usage)
+		for _, msg := range event.Messages {
+			fmt.Printf("  %s: %s\n", msg.Role, msg.Content)
+		}
+	}
+
+	agent.RegisterTypedTool(runner, "get_weather", "Get current weather for a city.",
+		func(ctx context.Context, args weatherArgs) (any, error) {
+			return map[string]any{
+				"city":      args.City,
+				"temp_c":    5 + rand.Intn(25),
+				"condition": []string{"rain", "sunny", "cloudy", "windy"}[rand.Intn(4)],
+			}, nil
+		},
+	)
+
+	final, err := runner.Run(ctx, "What's the weather in Seattle today?")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("Final answer:", final.Content)
+}
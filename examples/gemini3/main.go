@@ -9,35 +9,25 @@ import (
 	"time"
 
 	openai "github.com/sashabaranov/go-openai"
-	"golang.org/x/oauth2/google"
+	"github.com/sashabaranov/go-openai/jsonschema"
 )
 
 func main() {
 	ctx := context.Background()
 
-	creds, err := google.FindDefaultCredentials(ctx, "https://www.googleapis.com/auth/cloud-platform")
-	if err != nil {
-		panic(err)
-	}
-	tok, err := creds.TokenSource.Token()
-	if err != nil {
-		panic(err)
-	}
-	//fmt.Printf("Access token (expires %s): %s\n\n", tok.Expiry.Format(time.RFC3339), tok.AccessToken)
-
 	project := os.Getenv("GOOGLE_CLOUD_PROJECT")
 	location := os.Getenv("GOOGLE_CLOUD_LOCATION")
 	if project == "" || location == "" {
 		panic("GOOGLE_CLOUD_PROJECT and GOOGLE_CLOUD_LOCATION must be set")
 	}
 
-	baseURL := fmt.Sprintf(
-		"https://aiplatform.googleapis.com/v1/projects/%s/locations/%s/endpoints/openapi",
-		project, location,
-	)
-
-	cfg := openai.DefaultConfig(tok.AccessToken)
-	cfg.BaseURL = baseURL
+	// NewVertexAIConfig refreshes the underlying access token on every
+	// request, so unlike a one-shot FindDefaultCredentials call this client
+	// is safe to keep around past the ~1 hour token lifetime.
+	cfg, err := openai.NewVertexAIConfig(ctx, project, location)
+	if err != nil {
+		panic(err)
+	}
 
 	client := openai.NewClientWithConfig(cfg)
 
@@ -59,16 +49,10 @@ func main() {
 				Function: &openai.FunctionDefinition{
 					Name:        "get_weather",
 					Description: "Get current weather for a city.",
-					Parameters: map[string]any{
-						"type": "object",
-						"properties": map[string]any{
-							"city": map[string]any{
-								"type":        "string",
-								"description": "City name, e.g. Seattle",
-							},
-						},
-						"required": []string{"city"},
-					},
+					Parameters: jsonschema.Object().
+						Prop("city", jsonschema.String().Desc("City name, e.g. Seattle")).
+						Required("city").
+						Build(),
 				},
 			},
 			{
@@ -76,25 +60,18 @@ func main() {
 				Function: &openai.FunctionDefinition{
 					Name:        "get_current_time",
 					Description: "Get the current time. Optionally specify an IANA timezone like America/Los_Angeles.",
-					Parameters: map[string]any{
-						"type": "object",
-						"properties": map[string]any{
-							"timezone": map[string]any{
-								"type":        "string",
-								"description": "Optional IANA timezone. Defaults to local time.",
-							},
-						},
-					},
+					Parameters: jsonschema.Object().
+						Prop("timezone", jsonschema.String().Desc("Optional IANA timezone. Defaults to local time.")).
+						Build(),
 				},
 			},
 		},
 		ToolChoice: "auto",
-		ExtraBody: map[string]any{
-			"google": map[string]any{
-				"thinking_config": map[string]any{
-					"include_thoughts": true,
-				},
-			},
+		Thinking: &openai.ThinkingConfig{
+			IncludeThoughts: true,
+		},
+		FunctionCalling: &openai.FunctionCallingConfig{
+			Mode: openai.FunctionCallingModeAuto,
 		},
 	}
 
@@ -105,8 +82,8 @@ func main() {
 
 	assistantMsg := resp.Choices[0].Message
 	fmt.Println("Reply:", assistantMsg.Content)
-	if len(assistantMsg.MultiContent) > 0 {
-		fmt.Printf("Thought signature: %+v\n", assistantMsg.MultiContent[0].ExtraPart)
+	for _, thought := range assistantMsg.Thoughts {
+		fmt.Printf("Thought signature: %x\n", thought.Signature)
 	}
 
 	if len(assistantMsg.ToolCalls) == 0 {
@@ -184,7 +161,7 @@ func main() {
 	}
 	finalMsg := resp2.Choices[0].Message
 	fmt.Println("Final answer:", finalMsg.Content)
-	if len(finalMsg.MultiContent) > 0 {
-		fmt.Printf("Final thought signature: %+v\n", finalMsg.MultiContent[0].ExtraPart)
+	for _, thought := range finalMsg.Thoughts {
+		fmt.Printf("Final thought signature: %x\n", thought.Signature)
 	}
 }
@@ -0,0 +1,95 @@
+package openai
+
+import "strings"
+
+// ThinkingConfig enables and tunes Gemini's extended thinking. The client
+// serializes it into the provider-specific extra_body.google.thinking_config
+// shape when the request targets a Gemini/Vertex endpoint (see
+// Config.Provider and isGeminiConfig); it is a no-op on the wire for every
+// other provider.
+type ThinkingConfig struct {
+	// IncludeThoughts asks the model to return its intermediate reasoning
+	// as ThoughtPart entries on the response message.
+	IncludeThoughts bool
+	// ThinkingBudget caps the number of thinking tokens the model may
+	// spend. Nil leaves the provider default in place.
+	ThinkingBudget *int
+}
+
+// ThoughtPart is a single piece of model reasoning returned alongside a
+// ChatCompletionMessage when ThinkingConfig.IncludeThoughts is set.
+// Signature must be echoed back verbatim in a later turn's message history
+// for providers (such as Vertex) that require it to preserve reasoning
+// context across tool calls.
+type ThoughtPart struct {
+	Text      string `json:"text"`
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// isGeminiConfig reports whether requests built from cfg should have
+// Gemini-specific extensions (thinking config, function calling config)
+// folded into extra_body.google.
+func isGeminiConfig(cfg Config) bool {
+	return cfg.Provider == ProviderGemini || strings.Contains(cfg.BaseURL, "aiplatform.googleapis.com")
+}
+
+// googleExtraBody returns the "google" sub-map of req.ExtraBody, creating
+// both as needed.
+func googleExtraBody(req *ChatCompletionRequest) map[string]any {
+	if req.ExtraBody == nil {
+		req.ExtraBody = map[string]any{}
+	}
+	google, _ := req.ExtraBody["google"].(map[string]any)
+	if google == nil {
+		google = map[string]any{}
+		req.ExtraBody["google"] = google
+	}
+	return google
+}
+
+// applyGeminiExtensions folds the typed Gemini-only request fields into
+// extra_body.google before the request is marshaled. It is a no-op for
+// non-Gemini configs and when none of those fields are set.
+func applyGeminiExtensions(req *ChatCompletionRequest, cfg Config) {
+	if !isGeminiConfig(cfg) {
+		return
+	}
+
+	if tc := req.Thinking; tc != nil {
+		thinkingConfig := map[string]any{
+			"include_thoughts": tc.IncludeThoughts,
+		}
+		if tc.ThinkingBudget != nil {
+			thinkingConfig["thinking_budget"] = *tc.ThinkingBudget
+		}
+		googleExtraBody(req)["thinking_config"] = thinkingConfig
+	}
+
+	if fc := req.FunctionCalling; fc != nil {
+		functionCallingConfig := map[string]any{
+			"mode": fc.Mode,
+		}
+		if len(fc.AllowedFunctionNames) > 0 {
+			functionCallingConfig["allowed_function_names"] = fc.AllowedFunctionNames
+		}
+		googleExtraBody(req)["function_calling_config"] = functionCallingConfig
+	}
+}
+
+// extractThoughts pulls any "thought" content parts out of msg.MultiContent
+// and into msg.Thoughts, so callers don't need to dig through ExtraPart by
+// hand.
+func extractThoughts(msg *ChatCompletionMessage) {
+	for _, part := range msg.MultiContent {
+		if part.Type != "thought" {
+			continue
+		}
+		thought := ThoughtPart{Text: part.Text}
+		if extra, ok := part.ExtraPart.(map[string]any); ok {
+			if sig, ok := extra["thought_signature"].(string); ok {
+				thought.Signature = []byte(sig)
+			}
+		}
+		msg.Thoughts = append(msg.Thoughts, thought)
+	}
+}
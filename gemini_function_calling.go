@@ -0,0 +1,28 @@
+package openai
+
+// FunctionCallingMode is the mode of Gemini/Vertex's
+// function_calling_config, independent of the OpenAI-style ToolChoice
+// field.
+type FunctionCallingMode string
+
+const (
+	// FunctionCallingModeAuto lets the model decide whether to call a
+	// function, matching ToolChoice: "auto".
+	FunctionCallingModeAuto FunctionCallingMode = "AUTO"
+	// FunctionCallingModeAny forces the model to call a function, optionally
+	// restricted to AllowedFunctionNames.
+	FunctionCallingModeAny FunctionCallingMode = "ANY"
+	// FunctionCallingModeNone disables function calling entirely.
+	FunctionCallingModeNone FunctionCallingMode = "NONE"
+)
+
+// FunctionCallingConfig is Gemini/Vertex's function_calling_config. The
+// client serializes it into extra_body.google.function_calling_config,
+// alongside extra_body.google.thinking_config; it is sent in addition to
+// ToolChoice, which is left unchanged.
+type FunctionCallingConfig struct {
+	Mode FunctionCallingMode
+	// AllowedFunctionNames restricts which tools the model may call when
+	// Mode is FunctionCallingModeAny. Ignored for the other modes.
+	AllowedFunctionNames []string
+}
@@ -0,0 +1,123 @@
+package openai
+
+import "testing"
+
+func TestIsGeminiConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want bool
+	}{
+		{"openai default", DefaultConfig("token"), false},
+		{"provider override", Config{Provider: ProviderGemini}, true},
+		{"vertex base url", Config{BaseURL: "https://us-central1-aiplatform.googleapis.com/v1/..."}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isGeminiConfig(tt.cfg); got != tt.want {
+				t.Errorf("isGeminiConfig(%+v) = %v, want %v", tt.cfg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyGeminiExtensionsNoOpForNonGemini(t *testing.T) {
+	budget := 100
+	req := ChatCompletionRequest{
+		Thinking:        &ThinkingConfig{IncludeThoughts: true, ThinkingBudget: &budget},
+		FunctionCalling: &FunctionCallingConfig{Mode: FunctionCallingModeAny},
+	}
+	applyGeminiExtensions(&req, DefaultConfig("token"))
+
+	if req.ExtraBody != nil {
+		t.Errorf("ExtraBody = %+v, want nil for a non-Gemini config", req.ExtraBody)
+	}
+}
+
+func TestApplyGeminiExtensionsThinkingConfig(t *testing.T) {
+	budget := 100
+	req := ChatCompletionRequest{
+		Thinking: &ThinkingConfig{IncludeThoughts: true, ThinkingBudget: &budget},
+	}
+	applyGeminiExtensions(&req, Config{Provider: ProviderGemini})
+
+	google, ok := req.ExtraBody["google"].(map[string]any)
+	if !ok {
+		t.Fatalf("ExtraBody[\"google\"] = %+v, want map[string]any", req.ExtraBody["google"])
+	}
+	thinkingConfig, ok := google["thinking_config"].(map[string]any)
+	if !ok {
+		t.Fatalf("google[\"thinking_config\"] = %+v, want map[string]any", google["thinking_config"])
+	}
+	if thinkingConfig["include_thoughts"] != true {
+		t.Errorf("include_thoughts = %v, want true", thinkingConfig["include_thoughts"])
+	}
+	if thinkingConfig["thinking_budget"] != 100 {
+		t.Errorf("thinking_budget = %v, want 100", thinkingConfig["thinking_budget"])
+	}
+}
+
+func TestApplyGeminiExtensionsFunctionCallingConfig(t *testing.T) {
+	req := ChatCompletionRequest{
+		FunctionCalling: &FunctionCallingConfig{
+			Mode:                 FunctionCallingModeAny,
+			AllowedFunctionNames: []string{"get_weather"},
+		},
+	}
+	applyGeminiExtensions(&req, Config{Provider: ProviderGemini})
+
+	google := req.ExtraBody["google"].(map[string]any)
+	functionCallingConfig, ok := google["function_calling_config"].(map[string]any)
+	if !ok {
+		t.Fatalf("google[\"function_calling_config\"] = %+v, want map[string]any", google["function_calling_config"])
+	}
+	if functionCallingConfig["mode"] != FunctionCallingModeAny {
+		t.Errorf("mode = %v, want %v", functionCallingConfig["mode"], FunctionCallingModeAny)
+	}
+	names, ok := functionCallingConfig["allowed_function_names"].([]string)
+	if !ok || len(names) != 1 || names[0] != "get_weather" {
+		t.Errorf("allowed_function_names = %v, want [get_weather]", functionCallingConfig["allowed_function_names"])
+	}
+}
+
+func TestApplyGeminiExtensionsBothShareExtraBody(t *testing.T) {
+	req := ChatCompletionRequest{
+		Thinking:        &ThinkingConfig{IncludeThoughts: true},
+		FunctionCalling: &FunctionCallingConfig{Mode: FunctionCallingModeAuto},
+	}
+	applyGeminiExtensions(&req, Config{Provider: ProviderGemini})
+
+	google := req.ExtraBody["google"].(map[string]any)
+	if _, ok := google["thinking_config"]; !ok {
+		t.Error("thinking_config missing from extra_body.google")
+	}
+	if _, ok := google["function_calling_config"]; !ok {
+		t.Error("function_calling_config missing from extra_body.google")
+	}
+}
+
+func TestExtractThoughts(t *testing.T) {
+	msg := ChatCompletionMessage{
+		MultiContent: []ChatMessagePart{
+			{Type: "text", Text: "hello"},
+			{
+				Type: "thought",
+				Text: "thinking...",
+				ExtraPart: map[string]any{
+					"thought_signature": "sig-bytes",
+				},
+			},
+		},
+	}
+	extractThoughts(&msg)
+
+	if len(msg.Thoughts) != 1 {
+		t.Fatalf("got %d thoughts, want 1", len(msg.Thoughts))
+	}
+	if msg.Thoughts[0].Text != "thinking..." {
+		t.Errorf("Thoughts[0].Text = %q, want %q", msg.Thoughts[0].Text, "thinking...")
+	}
+	if string(msg.Thoughts[0].Signature) != "sig-bytes" {
+		t.Errorf("Thoughts[0].Signature = %q, want %q", msg.Thoughts[0].Signature, "sig-bytes")
+	}
+}
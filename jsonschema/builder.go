@@ -0,0 +1,76 @@
+package jsonschema
+
+// Builder fluently assembles a Schema. Compose leaf schemas first (e.g.
+// jsonschema.String().Desc("City name")), attach them to an object with
+// Prop, and finish with Build:
+//
+//	jsonschema.Object().
+//		Prop("city", jsonschema.String().Desc("City name")).
+//		Required("city").
+//		Build()
+type Builder struct {
+	schema Schema
+}
+
+// Object starts building an object schema.
+func Object() *Builder {
+	return &Builder{schema: Schema{Type: TypeObject}}
+}
+
+// String starts building a string schema.
+func String() *Builder {
+	return &Builder{schema: Schema{Type: TypeString}}
+}
+
+// Number starts building a number schema.
+func Number() *Builder {
+	return &Builder{schema: Schema{Type: TypeNumber}}
+}
+
+// Integer starts building an integer schema.
+func Integer() *Builder {
+	return &Builder{schema: Schema{Type: TypeInteger}}
+}
+
+// Boolean starts building a boolean schema.
+func Boolean() *Builder {
+	return &Builder{schema: Schema{Type: TypeBoolean}}
+}
+
+// Array starts building an array schema whose elements match items.
+func Array(items *Builder) *Builder {
+	itemSchema := items.Build()
+	return &Builder{schema: Schema{Type: TypeArray, Items: &itemSchema}}
+}
+
+// Desc sets the schema's description.
+func (b *Builder) Desc(description string) *Builder {
+	b.schema.Description = description
+	return b
+}
+
+// Enum restricts the schema to the given values.
+func (b *Builder) Enum(values ...string) *Builder {
+	b.schema.Enum = values
+	return b
+}
+
+// Prop adds a named property to an object schema.
+func (b *Builder) Prop(name string, value *Builder) *Builder {
+	if b.schema.Properties == nil {
+		b.schema.Properties = map[string]Schema{}
+	}
+	b.schema.Properties[name] = value.Build()
+	return b
+}
+
+// Required marks the given properties as required on an object schema.
+func (b *Builder) Required(names ...string) *Builder {
+	b.schema.Required = append(b.schema.Required, names...)
+	return b
+}
+
+// Build returns the assembled Schema.
+func (b *Builder) Build() Schema {
+	return b.schema
+}
@@ -0,0 +1,34 @@
+package jsonschema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	got := Object().
+		Prop("city", String().Desc("City name, e.g. Seattle")).
+		Prop("unit", String().Enum("celsius", "fahrenheit")).
+		Required("city").
+		Build()
+
+	want := Schema{
+		Type: TypeObject,
+		Properties: map[string]Schema{
+			"city": {Type: TypeString, Description: "City name, e.g. Seattle"},
+			"unit": {Type: TypeString, Enum: []string{"celsius", "fahrenheit"}},
+		},
+		Required: []string{"city"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Build() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuilderArray(t *testing.T) {
+	got := Array(Integer()).Build()
+	want := Schema{Type: TypeArray, Items: &Schema{Type: TypeInteger}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Array(Integer()).Build() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+// Package jsonschema provides a typed representation of the JSON Schema
+// subset used by FunctionDefinition.Parameters, plus a fluent builder and a
+// reflect-based derivation from Go structs, as an alternative to hand
+// assembling map[string]any literals.
+package jsonschema
+
+// DataType is the "type" keyword of a JSON Schema node.
+type DataType string
+
+const (
+	TypeObject  DataType = "object"
+	TypeNumber  DataType = "number"
+	TypeInteger DataType = "integer"
+	TypeString  DataType = "string"
+	TypeArray   DataType = "array"
+	TypeNull    DataType = "null"
+	TypeBoolean DataType = "boolean"
+)
+
+// Definition describes a JSON Schema node, sufficient to express a tool's
+// FunctionDefinition.Parameters.
+type Definition struct {
+	Type        DataType              `json:"type,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Enum        []string              `json:"enum,omitempty"`
+	Properties  map[string]Definition `json:"properties,omitempty"`
+	Required    []string              `json:"required,omitempty"`
+	Items       *Definition           `json:"items,omitempty"`
+}
+
+// Schema is an alias for Definition. FunctionDefinition.Parameters, the
+// Builder, and From accept and return Schema; use whichever name
+// reads better at the call site.
+type Schema = Definition
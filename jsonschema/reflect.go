@@ -0,0 +1,106 @@
+package jsonschema
+
+import (
+	"reflect"
+	"strings"
+)
+
+// From derives a Schema from T's exported fields, using each field's json
+// tag for the property name and a `jsonschema:"description=...;enum=a|b|c"`
+// struct tag for the description and enum. Directives are separated by
+// ";" rather than "," so a description can itself contain commas. A field
+// is required unless its json tag has ",omitempty" or its json name is
+// "-". T must be a struct or a pointer to one.
+func From[T any]() Schema {
+	var zero T
+	t := reflect.TypeOf(zero)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return fromStruct(t)
+}
+
+func fromStruct(t reflect.Type) Schema {
+	schema := Schema{Type: TypeObject, Properties: map[string]Schema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, omitempty, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		propSchema := fromType(field.Type)
+		propSchema.Description, propSchema.Enum = parseJSONSchemaTag(field.Tag.Get("jsonschema"))
+
+		schema.Properties[name] = propSchema
+		if !omitempty {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+func fromType(t reflect.Type) Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{Type: TypeString}
+	case reflect.Bool:
+		return Schema{Type: TypeBoolean}
+	case reflect.Float32, reflect.Float64:
+		return Schema{Type: TypeNumber}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{Type: TypeInteger}
+	case reflect.Slice, reflect.Array:
+		item := fromType(t.Elem())
+		return Schema{Type: TypeArray, Items: &item}
+	case reflect.Struct:
+		return fromStruct(t)
+	default:
+		return Schema{}
+	}
+}
+
+func jsonFieldName(field reflect.StructField) (name string, omitempty, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	name = field.Name
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	for _, p := range parts[1:] {
+		if p == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, false
+}
+
+func parseJSONSchemaTag(tag string) (description string, enum []string) {
+	if tag == "" {
+		return "", nil
+	}
+	for _, directive := range strings.Split(tag, ";") {
+		kv := strings.SplitN(directive, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "description":
+			description = kv[1]
+		case "enum":
+			enum = strings.Split(kv[1], "|")
+		}
+	}
+	return description, enum
+}
@@ -0,0 +1,83 @@
+package jsonschema
+
+import (
+	"reflect"
+	"testing"
+)
+
+type weatherArgs struct {
+	City  string   `json:"city" jsonschema:"description=City name, e.g. Seattle"`
+	Unit  string   `json:"unit,omitempty" jsonschema:"enum=celsius|fahrenheit"`
+	Tags  []string `json:"tags,omitempty"`
+	Count int      `json:"count"`
+}
+
+func TestFromDerivesObjectSchema(t *testing.T) {
+	got := From[weatherArgs]()
+
+	city, ok := got.Properties["city"]
+	if !ok {
+		t.Fatal("missing \"city\" property")
+	}
+	if city.Type != TypeString {
+		t.Errorf("city.Type = %v, want %v", city.Type, TypeString)
+	}
+	// The comma in the description must survive: directives are separated
+	// by ";" ("jsonschema:\"a=..;b=..\""), so a comma is part of the value.
+	if city.Description != "City name, e.g. Seattle" {
+		t.Errorf("city.Description = %q, want %q", city.Description, "City name, e.g. Seattle")
+	}
+
+	unit, ok := got.Properties["unit"]
+	if !ok {
+		t.Fatal("missing \"unit\" property")
+	}
+	if !reflect.DeepEqual(unit.Enum, []string{"celsius", "fahrenheit"}) {
+		t.Errorf("unit.Enum = %v, want [celsius fahrenheit]", unit.Enum)
+	}
+
+	tags, ok := got.Properties["tags"]
+	if !ok {
+		t.Fatal("missing \"tags\" property")
+	}
+	if tags.Type != TypeArray || tags.Items == nil || tags.Items.Type != TypeString {
+		t.Errorf("tags = %+v, want array of string", tags)
+	}
+
+	wantRequired := map[string]bool{"city": true, "count": true}
+	if len(got.Required) != len(wantRequired) {
+		t.Fatalf("Required = %v, want exactly %v", got.Required, wantRequired)
+	}
+	for _, name := range got.Required {
+		if !wantRequired[name] {
+			t.Errorf("unexpected required field %q", name)
+		}
+	}
+	for _, omitted := range []string{"unit", "tags"} {
+		for _, name := range got.Required {
+			if name == omitted {
+				t.Errorf("%q marked required, want omitted (has omitempty)", omitted)
+			}
+		}
+	}
+}
+
+func TestFromPointerToStruct(t *testing.T) {
+	got := From[*weatherArgs]()
+	if got.Type != TypeObject {
+		t.Errorf("From[*weatherArgs]().Type = %v, want %v", got.Type, TypeObject)
+	}
+	if _, ok := got.Properties["city"]; !ok {
+		t.Error("missing \"city\" property for pointer type param")
+	}
+}
+
+func TestParseJSONSchemaTagDescriptionWithComma(t *testing.T) {
+	description, enum := parseJSONSchemaTag("description=City name, e.g. Seattle;enum=a|b")
+	if description != "City name, e.g. Seattle" {
+		t.Errorf("description = %q, want %q", description, "City name, e.g. Seattle")
+	}
+	if !reflect.DeepEqual(enum, []string{"a", "b"}) {
+		t.Errorf("enum = %v, want [a b]", enum)
+	}
+}
@@ -0,0 +1,6 @@
+package openai
+
+const (
+	GPT4o             = "gpt-4o"
+	Gemini3ProPreview = "gemini-3-pro-preview"
+)
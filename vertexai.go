@@ -0,0 +1,100 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// DefaultVertexAIScopes are the OAuth2 scopes requested when no scopes are
+// supplied to NewVertexAIConfig.
+var DefaultVertexAIScopes = []string{"https://www.googleapis.com/auth/cloud-platform"}
+
+// VertexOption customizes the Config built by NewVertexAIConfig.
+type VertexOption func(*vertexAIOptions)
+
+type vertexAIOptions struct {
+	scopes          []string
+	credentialsJSON []byte
+}
+
+// WithVertexAIScopes overrides the OAuth2 scopes used to obtain the
+// underlying Google credentials.
+func WithVertexAIScopes(scopes ...string) VertexOption {
+	return func(o *vertexAIOptions) {
+		o.scopes = scopes
+	}
+}
+
+// WithVertexAICredentialsJSON sources credentials from the given service
+// account or authorized-user JSON instead of the ambient default
+// credentials (environment, metadata server, gcloud ADC file, ...).
+func WithVertexAICredentialsJSON(credentialsJSON []byte) VertexOption {
+	return func(o *vertexAIOptions) {
+		o.credentialsJSON = credentialsJSON
+	}
+}
+
+// NewVertexAIConfig builds a Config that talks to the Vertex AI
+// OpenAI-compatible endpoint for the given project and location.
+//
+// Unlike DefaultConfig, the returned Config's HTTPClient refreshes its
+// access token on every request via an http.RoundTripper wrapping the
+// Google credentials' oauth2.TokenSource, so a single Client can be reused
+// past the ~1 hour lifetime of a Google access token. Token caching and
+// refresh is handled by the TokenSource itself.
+func NewVertexAIConfig(ctx context.Context, project, location string, opts ...VertexOption) (Config, error) {
+	o := vertexAIOptions{scopes: DefaultVertexAIScopes}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var (
+		creds *google.Credentials
+		err   error
+	)
+	if o.credentialsJSON != nil {
+		creds, err = google.CredentialsFromJSON(ctx, o.credentialsJSON, o.scopes...)
+	} else {
+		creds, err = google.FindDefaultCredentials(ctx, o.scopes...)
+	}
+	if err != nil {
+		return Config{}, fmt.Errorf("vertex ai: loading credentials: %w", err)
+	}
+
+	cfg := DefaultConfig("")
+	cfg.BaseURL = fmt.Sprintf(
+		"https://%s-aiplatform.googleapis.com/v1/projects/%s/locations/%s/endpoints/openapi",
+		location, project, location,
+	)
+	cfg.Provider = ProviderGemini
+	cfg.HTTPClient = &http.Client{
+		Transport: &vertexAITransport{
+			source: creds.TokenSource,
+			base:   http.DefaultTransport,
+		},
+	}
+	return cfg, nil
+}
+
+// vertexAITransport injects a bearer token obtained from source into every
+// outgoing request. oauth2.TokenSource implementations already cache the
+// token and only hit the network again once it is near expiry, so this
+// adds no extra latency to the common case.
+type vertexAITransport struct {
+	source oauth2.TokenSource
+	base   http.RoundTripper
+}
+
+func (t *vertexAITransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := t.source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("vertex ai: refreshing token: %w", err)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+tok.AccessToken)
+	return t.base.RoundTrip(req)
+}